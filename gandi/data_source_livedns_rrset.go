@@ -0,0 +1,75 @@
+package gandi
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceLiveDNSRRset reads an existing rrset identified by (zone, name, type).
+func dataSourceLiveDNSRRset() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLiveDNSRRsetRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the domain",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rrset",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of the rrset",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The TTL of the rrset",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href of the rrset",
+			},
+			"values": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The full list of values of the rrset",
+			},
+		},
+	}
+}
+
+func dataSourceLiveDNSRRsetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+
+	zone := d.Get("zone").(string)
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+
+	rrset, err := client.GetDomainRecordByNameAndType(zone, name, recordType)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", zone, name, recordType))
+
+	if err = d.Set("ttl", rrset.RrsetTTL); err != nil {
+		return fmt.Errorf("failed to set ttl for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("href", rrset.RrsetHref); err != nil {
+		return fmt.Errorf("failed to set href for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("values", rrset.RrsetValues); err != nil {
+		return fmt.Errorf("failed to set the values for %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package gandi
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// answersSchema returns the nested "answers" block shared by
+// resourceLiveDNSRecord and resourceLiveDNSRRset. It is an alternative to
+// the flat "values" set for users who want to express traffic-steering
+// intent structurally instead of hand-crafting value strings.
+func answersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ExactlyOneOf: []string{"values", "answers"},
+		Description:  "A list of routed answers. Diffed structurally; translated to the closest construct Gandi LiveDNS can express, or rejected with an error at apply time when it cannot.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"answer": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The value returned for this answer, e.g. an IP address",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Region to route this answer to. Not supported by Gandi LiveDNS; any non-empty region is an error at apply time.",
+				},
+				"country": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Country to route this answer to. Not supported by Gandi LiveDNS; any non-empty country is an error at apply time.",
+				},
+				"weight": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Relative weight of this answer. Not supported: rrset values are stored as a deduplicated set, so there is no way to make one answer heavier than another. Only 0 or 1 (an unweighted answer) is accepted; any other weight is an error at apply time.",
+				},
+			},
+		},
+	}
+}
+
+// expandAnswers translates an "answers" block into the flat values list
+// LiveDNS stores, or returns a validation error for constructs Gandi
+// cannot express. Region/country routing can never be expressed. Weight
+// can't either: rrset values are a deduplicated set (schema.TypeSet), so
+// repeating an answer's value to simulate weight collapses right back
+// down to one entry instead of influencing anything.
+func expandAnswers(recordType string, answers []interface{}) ([]string, error) {
+	values := make([]string, 0, len(answers))
+	for _, a := range answers {
+		answer := a.(map[string]interface{})
+
+		if region, _ := answer["region"].(string); region != "" {
+			return nil, fmt.Errorf("gandi livedns cannot express region-routed answers: region %q set on answer %q", region, answer["answer"])
+		}
+		if country, _ := answer["country"].(string); country != "" {
+			return nil, fmt.Errorf("gandi livedns cannot express country-routed answers: country %q set on answer %q", country, answer["answer"])
+		}
+		if weight, _ := answer["weight"].(int); weight != 0 && weight != 1 {
+			return nil, fmt.Errorf("gandi livedns cannot express weighted answers: weight %d set on answer %q", weight, answer["answer"])
+		}
+
+		values = append(values, answer["answer"].(string))
+	}
+	return values, nil
+}
@@ -0,0 +1,60 @@
+package gandi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandAnswers(t *testing.T) {
+	t.Run("plain answers become values in order", func(t *testing.T) {
+		answers := []interface{}{
+			map[string]interface{}{"answer": "192.168.0.1"},
+			map[string]interface{}{"answer": "192.168.0.2"},
+		}
+		values, err := expandAnswers("A", answers)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		awaited := []string{"192.168.0.1", "192.168.0.2"}
+		if !reflect.DeepEqual(values, awaited) {
+			t.Errorf("got %v, want %v", values, awaited)
+		}
+	})
+
+	t.Run("weight 0 or 1 is accepted", func(t *testing.T) {
+		answers := []interface{}{
+			map[string]interface{}{"answer": "192.168.0.1", "weight": 0},
+			map[string]interface{}{"answer": "192.168.0.2", "weight": 1},
+		}
+		if _, err := expandAnswers("A", answers); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("weight greater than 1 is rejected", func(t *testing.T) {
+		answers := []interface{}{
+			map[string]interface{}{"answer": "192.168.0.1", "weight": 10},
+		}
+		if _, err := expandAnswers("A", answers); err == nil {
+			t.Errorf("expected an error for weight > 1")
+		}
+	})
+
+	t.Run("region is rejected", func(t *testing.T) {
+		answers := []interface{}{
+			map[string]interface{}{"answer": "192.168.0.1", "region": "europe"},
+		}
+		if _, err := expandAnswers("A", answers); err == nil {
+			t.Errorf("expected an error for a region-routed answer")
+		}
+	})
+
+	t.Run("country is rejected", func(t *testing.T) {
+		answers := []interface{}{
+			map[string]interface{}{"answer": "192.168.0.1", "country": "FR"},
+		}
+		if _, err := expandAnswers("A", answers); err == nil {
+			t.Errorf("expected an error for a country-routed answer")
+		}
+	})
+}
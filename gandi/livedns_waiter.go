@@ -0,0 +1,98 @@
+package gandi
+
+import (
+	"time"
+
+	"github.com/go-gandi/go-gandi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	recordStatusPending = "pending"
+	recordStatusDone    = "done"
+)
+
+// LiveDNSOperationWaiter polls a single rrset after a create/update/delete
+// until the observed state matches what was just requested, retrying on
+// transient 429/5xx responses with the exponential backoff StateChangeConf
+// applies between polls. LiveDNS mutations are synchronous REST calls with
+// no operation object to poll, so "done" here means "the rrset we just
+// wrote now reads back the way we wrote it".
+type LiveDNSOperationWaiter struct {
+	Client     *clients
+	Zone       string
+	Name       string
+	RecordType string
+}
+
+// WaitForRecord blocks until the rrset's TTL and values match what was
+// just written, or the timeout elapses.
+func (w *LiveDNSOperationWaiter) WaitForRecord(ttl int, values []string, timeout time.Duration) error {
+	conf := &resource.StateChangeConf{
+		Pending:    []string{recordStatusPending},
+		Target:     []string{recordStatusDone},
+		Refresh:    w.refreshRecord(ttl, values),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+// WaitForDelete blocks until the rrset 404s, or the timeout elapses.
+func (w *LiveDNSOperationWaiter) WaitForDelete(timeout time.Duration) error {
+	conf := &resource.StateChangeConf{
+		Pending:    []string{recordStatusPending},
+		Target:     []string{recordStatusDone},
+		Refresh:    w.refreshDeleted(),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+func (w *LiveDNSOperationWaiter) refreshRecord(ttl int, values []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rec, err := w.Client.LiveDNS.GetDomainRecordByNameAndType(w.Zone, w.Name, w.RecordType)
+		if err != nil {
+			if isTransientLiveDNSError(err) {
+				return nil, recordStatusPending, nil
+			}
+			return nil, "", err
+		}
+		if rec.RrsetTTL == ttl && valuesEqual(rec.RrsetValues, values) {
+			return rec, recordStatusDone, nil
+		}
+		return rec, recordStatusPending, nil
+	}
+}
+
+func (w *LiveDNSOperationWaiter) refreshDeleted() resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, err := w.Client.LiveDNS.GetDomainRecordByNameAndType(w.Zone, w.Name, w.RecordType)
+		if err == nil {
+			return struct{}{}, recordStatusPending, nil
+		}
+		requestError, ok := err.(*types.RequestError)
+		if ok && requestError.StatusCode == 404 {
+			return struct{}{}, recordStatusDone, nil
+		}
+		if isTransientLiveDNSError(err) {
+			return nil, recordStatusPending, nil
+		}
+		return nil, "", err
+	}
+}
+
+// isTransientLiveDNSError reports whether err is a rate-limit or server
+// error worth retrying rather than failing the operation on.
+func isTransientLiveDNSError(err error) bool {
+	requestError, ok := err.(*types.RequestError)
+	if !ok {
+		return false
+	}
+	return requestError.StatusCode == 429 || requestError.StatusCode >= 500
+}
@@ -0,0 +1,262 @@
+package gandi
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-gandi/go-gandi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const acmeChallengeLabel = "_acme-challenge"
+
+// maxCNAMEChainDepth bounds how many CNAME hops are followed when resolving
+// the delegated target of an ACME challenge name, guarding against a loop.
+const maxCNAMEChainDepth = 10
+
+// resourceLiveDNSACMEChallenge publishes the `_acme-challenge` TXT record
+// an ACME dns-01 solver expects: it follows any CNAME delegation of the
+// challenge name to find the name it must actually be published under,
+// and appends rather than replaces so several concurrent challenges on
+// the same name can coexist.
+//
+// The CNAME chain is only followed within the configured zone. A hop
+// whose target lies in a different zone is not followed: creation fails
+// with an error naming the target, and no record is published in that
+// other zone.
+func resourceLiveDNSACMEChallenge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiveDNSACMEChallengeCreate,
+		Read:   resourceLiveDNSACMEChallengeRead,
+		Delete: resourceLiveDNSACMEChallengeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The FQDN of the domain. Only CNAME delegation within this zone is followed; a chain that delegates to another zone is an error when the resource is created",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain (or subdomain, relative to zone) the ACME challenge is issued for",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     300,
+				Description: "The TTL of the challenge TXT record",
+			},
+			"key_authorization": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"token", "account_thumbprint"},
+				Description:   "The ACME key authorization (token.thumbprint). Mutually exclusive with token/account_thumbprint.",
+			},
+			"token": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"account_thumbprint"},
+				Description:  "The ACME challenge token, combined with account_thumbprint to form the key authorization",
+			},
+			"account_thumbprint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"token"},
+				Description:  "The SHA-256 thumbprint of the ACME account key, combined with token to form the key authorization",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The FQDN the challenge TXT record is actually published under, after following any CNAME delegation",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64url-encoded SHA-256 digest of the key authorization, published as the TXT record value",
+			},
+		},
+	}
+}
+
+// expandACMEChallengeID splits the "{zone}/{name}/{type}/{value}" id a
+// gandi_livedns_acme_challenge resource is stored under. It carries the
+// TXT value alongside the usual zone/name/type so that several concurrent
+// challenges on the same name each get their own resource identity.
+func expandACMEChallengeID(id string) (zone, name, recordType, value string, err error) {
+	splitID := strings.SplitN(id, "/", 4)
+	if len(splitID) != 4 {
+		err = errors.New("id format should be '{zone}/{name}/{type}/{value}'")
+		return
+	}
+	return splitID[0], splitID[1], splitID[2], splitID[3], nil
+}
+
+// computeChallengeValue returns lego's dns-01 TXT record value: the
+// base64url (no padding) SHA-256 digest of the key authorization.
+func computeChallengeValue(keyAuthorization string) string {
+	digest := sha256.Sum256([]byte(keyAuthorization))
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+// resolveChallengeTarget follows any CNAME chain starting at
+// `_acme-challenge.<domain>` (relative to zone) and returns the name the
+// TXT record must actually be published under. It only follows hops that
+// stay within zone; see the limitation documented on
+// resourceLiveDNSACMEChallenge.
+func resolveChallengeTarget(meta interface{}, zone, domain string) (string, error) {
+	client := meta.(*clients).LiveDNS
+	name := acmeChallengeLabel
+	if domain != zone {
+		name = acmeChallengeLabel + "." + strings.TrimSuffix(domain, "."+zone)
+	}
+
+	for i := 0; i < maxCNAMEChainDepth; i++ {
+		rec, err := client.GetDomainRecordByNameAndType(zone, name, "CNAME")
+		if err != nil {
+			requestError, ok := err.(*types.RequestError)
+			if ok && requestError.StatusCode == 404 {
+				return name, nil
+			}
+			return "", err
+		}
+		if len(rec.RrsetValues) == 0 {
+			return name, nil
+		}
+
+		target := strings.TrimSuffix(rec.RrsetValues[0], ".")
+		if !strings.HasSuffix(target, "."+zone) && target != zone {
+			// Targets in another zone are not followed; the error names
+			// the target so a separate gandi_livedns_acme_challenge can
+			// be created for that zone.
+			return "", fmt.Errorf("gandi_livedns_acme_challenge: %s.%s delegates to %s, which is outside zone %q; create a separate gandi_livedns_acme_challenge with zone set to the delegated zone instead", acmeChallengeLabel, domain, target, zone)
+		}
+		name = strings.TrimSuffix(target, "."+zone)
+	}
+	return "", fmt.Errorf("gandi: CNAME chain for %s.%s did not resolve within %d hops", acmeChallengeLabel, domain, maxCNAMEChainDepth)
+}
+
+func resourceLiveDNSACMEChallengeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+
+	zone := d.Get("zone").(string)
+	domain := d.Get("domain").(string)
+	ttl := d.Get("ttl").(int)
+
+	keyAuthorization := d.Get("key_authorization").(string)
+	if keyAuthorization == "" {
+		token := d.Get("token").(string)
+		thumbprint := d.Get("account_thumbprint").(string)
+		if token == "" || thumbprint == "" {
+			return errors.New("gandi_livedns_acme_challenge: one of key_authorization or (token and account_thumbprint) must be set")
+		}
+		keyAuthorization = token + "." + thumbprint
+	}
+	value := computeChallengeValue(keyAuthorization)
+	// LiveDNS stores and returns TXT values wrapped in double quotes.
+	quotedValue := "\"" + value + "\""
+
+	name, err := resolveChallengeTarget(meta, zone, domain)
+	if err != nil {
+		return err
+	}
+
+	rec, err := client.GetDomainRecordByNameAndType(zone, name, TXT)
+	if err == nil {
+		values := keepUniqueRecords(append(rec.RrsetValues, quotedValue))
+		if _, err = client.UpdateDomainRecordByNameAndType(zone, name, TXT, ttl, values); err != nil {
+			return err
+		}
+	} else {
+		requestError, ok := err.(*types.RequestError)
+		if !ok || requestError.StatusCode != 404 {
+			return err
+		}
+		if _, err = client.CreateDomainRecord(zone, name, TXT, ttl, []string{quotedValue}); err != nil {
+			return err
+		}
+	}
+
+	if err = d.Set("fqdn", fmt.Sprintf("%s.%s.", name, zone)); err != nil {
+		return fmt.Errorf("failed to set fqdn: %w", err)
+	}
+	if err = d.Set("value", value); err != nil {
+		return fmt.Errorf("failed to set value: %w", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", zone, name, TXT, value))
+	return nil
+}
+
+func resourceLiveDNSACMEChallengeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zone, name, _, value, err := expandACMEChallengeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rec, err := client.GetDomainRecordByNameAndType(zone, name, TXT)
+	if err != nil {
+		requestError, ok := err.(*types.RequestError)
+		if ok && requestError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if _, exists := containsRecord(rec.RrsetValues, "\""+value+"\""); !exists {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("fqdn", fmt.Sprintf("%s.%s.", name, zone)); err != nil {
+		return fmt.Errorf("failed to set fqdn for %s: %w", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceLiveDNSACMEChallengeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zone, name, _, value, err := expandACMEChallengeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rec, err := client.GetDomainRecordByNameAndType(zone, name, TXT)
+	if err != nil {
+		requestError, ok := err.(*types.RequestError)
+		if ok && requestError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	index, exists := containsRecord(rec.RrsetValues, "\""+value+"\"")
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	remaining := removeRecordFromValuesList(rec.RrsetValues, index)
+	if len(remaining) == 0 {
+		if err = client.DeleteDomainRecord(zone, name, TXT); err != nil {
+			return err
+		}
+	} else if _, err = client.UpdateDomainRecordByNameAndType(zone, name, TXT, rec.RrsetTTL, remaining); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
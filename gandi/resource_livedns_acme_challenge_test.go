@@ -0,0 +1,47 @@
+package gandi
+
+import "testing"
+
+func TestComputeChallengeValue(t *testing.T) {
+	t.Run("matches the base64url SHA-256 digest of the key authorization", func(t *testing.T) {
+		keyAuthorization := "evaGxfADs6pSRb2LAv9IZf17Dt3juxGJ-PCt92wr-oA.a-gEnVghazQAIs3mDr3z6PqWEU3YwrHI8V8eF8ZACfVzA"
+		awaited := "-0aqCs0cbawrqA6QYw0xfgbD2Vj6Wj9wfS9Gu_nZ510"
+		if got := computeChallengeValue(keyAuthorization); got != awaited {
+			t.Errorf("got %s, want %s", got, awaited)
+		}
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		if computeChallengeValue("token.thumbprint") != computeChallengeValue("token.thumbprint") {
+			t.Errorf("expected the same key authorization to always produce the same value")
+		}
+	})
+}
+
+func TestExpandACMEChallengeID(t *testing.T) {
+	t.Run("valid id", func(t *testing.T) {
+		zone, name, recordType, value, err := expandACMEChallengeID("example.com/_acme-challenge/TXT/abc123")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if zone != "example.com" || name != "_acme-challenge" || recordType != "TXT" || value != "abc123" {
+			t.Errorf("got (%s, %s, %s, %s)", zone, name, recordType, value)
+		}
+	})
+
+	t.Run("value containing a slash is kept whole", func(t *testing.T) {
+		_, _, _, value, err := expandACMEChallengeID("example.com/_acme-challenge/TXT/abc/123")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if value != "abc/123" {
+			t.Errorf("got %q, want %q", value, "abc/123")
+		}
+	})
+
+	t.Run("too few segments is an error", func(t *testing.T) {
+		if _, _, _, _, err := expandACMEChallengeID("example.com/_acme-challenge/TXT"); err == nil {
+			t.Errorf("expected an error for a malformed id")
+		}
+	})
+}
@@ -52,18 +52,24 @@ func resourceLiveDNSRecord() *schema.Resource {
 				Description: "The href of the record",
 			},
 			"values": {
-				Type:        schema.TypeSet,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Required:    true,
-				Description: "A list of values of the record",
+				Type:         schema.TypeSet,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ExactlyOneOf: []string{"values", "answers"},
+				Description:  "A list of values of the record",
 			},
+			"answers": answersSchema(),
 			"mutable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Define if the record can be modified outside Terraform",
 			},
 		},
-		Timeouts: &schema.ResourceTimeout{Default: schema.DefaultTimeout(1 * time.Minute)},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -97,19 +103,63 @@ func isRecordWrappedWithQuotes(record string) bool {
 	return strings.HasPrefix(record, "\"") && strings.HasSuffix(record, "\"")
 }
 
-func containsRecord(recordsList []string, recordToFind string) int {
+// wrapRecordsWithQuotes wraps each record in double quotes, the form
+// LiveDNS stores TXT values in, leaving already-wrapped records alone.
+func wrapRecordsWithQuotes(records []string) []string {
+	wrapped := make([]string, 0, len(records))
+	for _, record := range records {
+		if isRecordWrappedWithQuotes(record) {
+			wrapped = append(wrapped, record)
+		} else {
+			wrapped = append(wrapped, "\""+record+"\"")
+		}
+	}
+	return wrapped
+}
+
+// areStringSlicesEqual reports whether a and b hold the same strings in
+// the same order.
+func areStringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsRecord(recordsList []string, recordToFind string) (int, bool) {
 	for i, rec := range recordsList {
 		if rec == recordToFind {
-			return i
+			return i, true
 		}
 	}
-	return -1
+	return -1, false
 }
 
 func removeRecordFromValuesList(records []string, index int) []string {
 	return append(records[:index], records[index+1:]...)
 }
 
+// resolveRecordValues returns the flat values list to send to LiveDNS,
+// taking it from the structured "answers" block when set or from the
+// plain "values" set otherwise.
+func resolveRecordValues(d *schema.ResourceData, recordType string) ([]string, error) {
+	if answers := d.Get("answers").([]interface{}); len(answers) > 0 {
+		return expandAnswers(recordType, answers)
+	}
+
+	valuesList := d.Get("values").(*schema.Set).List()
+	values := make([]string, 0, len(valuesList))
+	for _, v := range valuesList {
+		values = append(values, v.(string))
+	}
+	return values, nil
+}
+
 func createRecord(d *schema.ResourceData, meta interface{}, zoneUUID, name, recordType string, ttl int, values []string) error {
 	client := meta.(*clients).LiveDNS
 
@@ -119,6 +169,12 @@ func createRecord(d *schema.ResourceData, meta interface{}, zoneUUID, name, reco
 	}
 	calculatedID := fmt.Sprintf("%s/%s/%s", zoneUUID, name, recordType)
 	d.SetId(calculatedID)
+
+	waiter := &LiveDNSOperationWaiter{Client: meta.(*clients), Zone: zoneUUID, Name: name, RecordType: recordType}
+	if err = waiter.WaitForRecord(ttl, values, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for %s to become consistent: %w", calculatedID, err)
+	}
+
 	return resourceLiveDNSRecordRead(d, meta)
 }
 
@@ -127,12 +183,11 @@ func resourceLiveDNSRecordCreate(d *schema.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	recordType := d.Get("type").(string)
 	ttl := d.Get("ttl").(int)
-	valuesList := d.Get("values").(*schema.Set).List()
 	mutable := d.Get("mutable").(bool)
 
-	var values []string
-	for _, v := range valuesList {
-		values = append(values, v.(string))
+	values, err := resolveRecordValues(d, recordType)
+	if err != nil {
+		return err
 	}
 	client := meta.(*clients).LiveDNS
 
@@ -184,7 +239,11 @@ func resourceLiveDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("href", record.RrsetHref); err != nil {
 		return fmt.Errorf("failed to set href for %s: %w", d.Id(), err)
 	}
-	if recordType == TXT && mutable {
+	if len(d.Get("answers").([]interface{})) > 0 {
+		// LiveDNS only ever returns a flat values list, so the routing
+		// metadata (region/country/weight) in "answers" can't be read back.
+		// Leave it as configured rather than reporting spurious drift.
+	} else if recordType == TXT && mutable {
 		// Keep only values defined within terraform rather than list of all records
 		if err = d.Set("values", d.Get("values").(*schema.Set).List()); err != nil {
 			return fmt.Errorf("failed to set the values for %s: %w", d.Id(), err)
@@ -207,10 +266,9 @@ func resourceLiveDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 
 	mutable := d.Get("mutable").(bool)
 	ttl := d.Get("ttl").(int)
-	valuesList := d.Get("values").(*schema.Set).List()
-	var values []string
-	for _, v := range valuesList {
-		values = append(values, v.(string))
+	values, err := resolveRecordValues(d, recordType)
+	if err != nil {
+		return err
 	}
 
 	if recordType == TXT && mutable {
@@ -218,23 +276,20 @@ func resourceLiveDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 		if err != nil {
 			return err
 		}
-		var recordsWithQuotes []string
 		existingAndManagedRecords := append(values, rec.RrsetValues...)
-		for i := range existingAndManagedRecords {
-			record := fmt.Sprintf("%v", existingAndManagedRecords[i])
-			if isRecordWrappedWithQuotes(record) {
-				recordsWithQuotes = append(recordsWithQuotes, record)
-			} else {
-				recordsWithQuotes = append(recordsWithQuotes, "\""+record+"\"")
-			}
-		}
-		values = keepUniqueRecords(recordsWithQuotes)
+		values = keepUniqueRecords(wrapRecordsWithQuotes(existingAndManagedRecords))
 	}
 
 	_, err = client.UpdateDomainRecordByNameAndType(zone, name, recordType, ttl, values)
 	if err != nil {
 		return err
 	}
+
+	waiter := &LiveDNSOperationWaiter{Client: meta.(*clients), Zone: zone, Name: name, RecordType: recordType}
+	if err = waiter.WaitForRecord(ttl, values, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("waiting for %s to become consistent: %w", d.Id(), err)
+	}
+
 	return resourceLiveDNSRecordRead(d, meta)
 }
 
@@ -249,26 +304,33 @@ func resourceLiveDNSRecordDelete(d *schema.ResourceData, meta interface{}) error
 
 	if recordType == TXT && mutable {
 		zoneUUID := d.Get("zone").(string)
-		valuesList := d.Get("values").(*schema.Set).List()
 		ttl := d.Get("ttl").(int)
+		managedValues, err := resolveRecordValues(d, recordType)
+		if err != nil {
+			return err
+		}
 
 		rec, err := client.GetDomainRecordByNameAndType(zoneUUID, name, recordType)
 		if err != nil {
 			return err
 		}
 
+		waiter := &LiveDNSOperationWaiter{Client: meta.(*clients), Zone: zoneUUID, Name: name, RecordType: recordType}
+
 		// If the amount of records returned by the API is equal to amount of records handled by terraform
 		// It means that all resources are managed by Terraform and then records can be safely deleted
 		// Otherwise we need to remove terraform managed records from the records list and update it
-		if len(rec.RrsetValues) == len(valuesList) {
+		if len(rec.RrsetValues) == len(managedValues) {
 			if err = client.DeleteDomainRecord(zone, name, recordType); err != nil {
 				return err
 			}
+			if err = waiter.WaitForDelete(d.Timeout(schema.TimeoutDelete)); err != nil {
+				return fmt.Errorf("waiting for %s to be deleted: %w", d.Id(), err)
+			}
 		} else {
 			var values []string = rec.RrsetValues
-			for _, v := range valuesList {
-				index := containsRecord(values, "\""+v.(string)+"\"")
-				if index != -1 {
+			for _, v := range managedValues {
+				if index, exists := containsRecord(values, "\""+v+"\""); exists {
 					values = removeRecordFromValuesList(values, index)
 				}
 			}
@@ -276,11 +338,18 @@ func resourceLiveDNSRecordDelete(d *schema.ResourceData, meta interface{}) error
 			if err != nil {
 				return err
 			}
+			if err = waiter.WaitForRecord(ttl, values, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return fmt.Errorf("waiting for %s to become consistent: %w", d.Id(), err)
+			}
 		}
 	} else {
 		if err = client.DeleteDomainRecord(zone, name, recordType); err != nil {
 			return err
 		}
+		waiter := &LiveDNSOperationWaiter{Client: meta.(*clients), Zone: zone, Name: name, RecordType: recordType}
+		if err = waiter.WaitForDelete(d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("waiting for %s to be deleted: %w", d.Id(), err)
+		}
 	}
 
 	d.SetId("")
@@ -0,0 +1,175 @@
+package gandi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gandi/go-gandi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceLiveDNSRRset models a complete RRset the way LiveDNS stores it:
+// one object per (zone, name, type) owning the entire values list. Unlike
+// gandi_livedns_record, it never merges with out-of-band values: every
+// apply replaces the rrset wholesale and destroy deletes it outright.
+func resourceLiveDNSRRset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiveDNSRRsetCreate,
+		Read:   resourceLiveDNSRRsetRead,
+		Update: resourceLiveDNSRRsetUpdate,
+		Delete: resourceLiveDNSRRsetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The FQDN of the domain",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the rrset",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the rrset",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The TTL of the rrset",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href of the rrset",
+			},
+			"values": {
+				Type:         schema.TypeSet,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ExactlyOneOf: []string{"values", "answers"},
+				Description:  "The full list of values of the rrset. Replaces any existing values on apply.",
+			},
+			"answers": answersSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{Default: schema.DefaultTimeout(1 * time.Minute)},
+	}
+}
+
+func expandRRsetValues(d *schema.ResourceData, recordType string) ([]string, error) {
+	if answers := d.Get("answers").([]interface{}); len(answers) > 0 {
+		return expandAnswers(recordType, answers)
+	}
+
+	valuesList := d.Get("values").(*schema.Set).List()
+	values := make([]string, 0, len(valuesList))
+	for _, v := range valuesList {
+		values = append(values, v.(string))
+	}
+	return values, nil
+}
+
+func resourceLiveDNSRRsetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+
+	zoneUUID := d.Get("zone").(string)
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+	ttl := d.Get("ttl").(int)
+	values, err := expandRRsetValues(d, recordType)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateDomainRecord(zoneUUID, name, recordType, ttl, values); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", zoneUUID, name, recordType))
+	return resourceLiveDNSRRsetRead(d, meta)
+}
+
+func resourceLiveDNSRRsetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zone, name, recordType, err := expandRecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rrset, err := client.GetDomainRecordByNameAndType(zone, name, recordType)
+	if err != nil {
+		requestError, ok := err.(*types.RequestError)
+		if ok && requestError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err = d.Set("zone", zone); err != nil {
+		return fmt.Errorf("failed to set zone for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("name", rrset.RrsetName); err != nil {
+		return fmt.Errorf("failed to set name for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("type", rrset.RrsetType); err != nil {
+		return fmt.Errorf("failed to set type for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("ttl", rrset.RrsetTTL); err != nil {
+		return fmt.Errorf("failed to set ttl for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("href", rrset.RrsetHref); err != nil {
+		return fmt.Errorf("failed to set href for %s: %w", d.Id(), err)
+	}
+	if len(d.Get("answers").([]interface{})) == 0 {
+		if err = d.Set("values", rrset.RrsetValues); err != nil {
+			return fmt.Errorf("failed to set the values for %s: %w", d.Id(), err)
+		}
+	}
+	// When "answers" is used, LiveDNS only ever returns a flat values list,
+	// so the routing metadata (region/country/weight) can't be read back;
+	// leave it as configured rather than reporting spurious drift.
+
+	return nil
+}
+
+func resourceLiveDNSRRsetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zone, name, recordType, err := expandRecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ttl := d.Get("ttl").(int)
+	values, err := expandRRsetValues(d, recordType)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.UpdateDomainRecordByNameAndType(zone, name, recordType, ttl, values); err != nil {
+		return err
+	}
+	return resourceLiveDNSRRsetRead(d, meta)
+}
+
+func resourceLiveDNSRRsetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zone, name, recordType, err := expandRecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err = client.DeleteDomainRecord(zone, name, recordType); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,310 @@
+package gandi
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-gandi/go-gandi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceLiveDNSZoneRecords manages a whole set of rrsets for a zone with
+// a single bulk PUT instead of one API call per record. It computes an
+// add/change/delete plan between the desired rrsets and what LiveDNS
+// currently holds, much like a zone diffing tool would, but applies it
+// as one request.
+func resourceLiveDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLiveDNSZoneRecordsCreateUpdate,
+		Read:   resourceLiveDNSZoneRecordsRead,
+		Update: resourceLiveDNSZoneRecordsCreateUpdate,
+		Delete: resourceLiveDNSZoneRecordsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The FQDN of the domain",
+			},
+			"rrset": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The desired rrsets for the zone. Applied together in a single LiveDNS PUT.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the rrset",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of the rrset",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The TTL of the rrset",
+						},
+						"values": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Required:    true,
+							Description: "The full list of values of the rrset",
+						},
+					},
+				},
+			},
+			"keep_unknown": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Keep rrsets present in the zone but not listed in rrset, instead of deleting them",
+			},
+			"ignored_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "rrset names to never touch, e.g. the apex SOA. Like keep_unknown survivors, these are resubmitted unchanged in the applied PUT, but they are kept even when keep_unknown is false.",
+			},
+			"ignored_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "rrset types to never touch, e.g. \"SOA\". Like keep_unknown survivors, these are resubmitted unchanged in the applied PUT, but they are kept even when keep_unknown is false.",
+			},
+			"applied_creates": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rrsets created by the last apply",
+			},
+			"applied_changes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rrsets changed by the last apply",
+			},
+			"applied_deletes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rrsets deleted by the last apply",
+			},
+		},
+	}
+}
+
+// rrsetKey identifies an rrset by the pair LiveDNS actually keys on.
+type rrsetKey struct {
+	name       string
+	recordType string
+}
+
+// desiredRRset is a (name,type) rrset as requested in configuration.
+type desiredRRset struct {
+	key    rrsetKey
+	ttl    int
+	values []string
+}
+
+func expandDesiredRRsets(d *schema.ResourceData) []desiredRRset {
+	rrsetList := d.Get("rrset").([]interface{})
+	desired := make([]desiredRRset, 0, len(rrsetList))
+	for _, r := range rrsetList {
+		rrset := r.(map[string]interface{})
+		valuesList := rrset["values"].(*schema.Set).List()
+		values := make([]string, 0, len(valuesList))
+		for _, v := range valuesList {
+			values = append(values, v.(string))
+		}
+		desired = append(desired, desiredRRset{
+			key:    rrsetKey{name: rrset["name"].(string), recordType: rrset["type"].(string)},
+			ttl:    rrset["ttl"].(int),
+			values: values,
+		})
+	}
+	return desired
+}
+
+func expandStringList(raw []interface{}) map[string]bool {
+	set := make(map[string]bool, len(raw))
+	for _, v := range raw {
+		set[v.(string)] = true
+	}
+	return set
+}
+
+func sortedValues(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func valuesEqual(a, b []string) bool {
+	a, b = sortedValues(a), sortedValues(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// zoneRecordsDiff is the add/change/delete plan between the desired
+// rrsets and what LiveDNS currently holds for the zone. keep holds
+// keep_unknown survivors, which are resubmitted verbatim so the bulk PUT
+// doesn't delete them; ignored holds ignored_names/ignored_types matches,
+// which are resubmitted verbatim in the same way regardless of
+// keep_unknown, so they're never changed or deleted.
+type zoneRecordsDiff struct {
+	creates []desiredRRset
+	changes []desiredRRset
+	deletes []rrsetKey
+	keep    []types.DomainRecord
+	ignored []types.DomainRecord
+}
+
+func diffZoneRecords(desired []desiredRRset, actual []types.DomainRecord, ignoredNames, ignoredTypes map[string]bool, keepUnknown bool) zoneRecordsDiff {
+	actualByKey := make(map[rrsetKey]types.DomainRecord, len(actual))
+	for _, rec := range actual {
+		actualByKey[rrsetKey{name: rec.RrsetName, recordType: rec.RrsetType}] = rec
+	}
+
+	var diff zoneRecordsDiff
+	seen := make(map[rrsetKey]bool, len(desired))
+	for _, want := range desired {
+		seen[want.key] = true
+		if have, ok := actualByKey[want.key]; ok {
+			if have.RrsetTTL != want.ttl || !valuesEqual(have.RrsetValues, want.values) {
+				diff.changes = append(diff.changes, want)
+			}
+		} else {
+			diff.creates = append(diff.creates, want)
+		}
+	}
+
+	for key, rec := range actualByKey {
+		if seen[key] {
+			continue
+		}
+		if ignoredNames[key.name] || ignoredTypes[key.recordType] {
+			diff.ignored = append(diff.ignored, rec)
+			continue
+		}
+		if keepUnknown {
+			diff.keep = append(diff.keep, rec)
+			continue
+		}
+		diff.deletes = append(diff.deletes, key)
+	}
+
+	return diff
+}
+
+func resourceLiveDNSZoneRecordsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zoneUUID := d.Get("zone").(string)
+
+	desired := expandDesiredRRsets(d)
+	ignoredNames := expandStringList(d.Get("ignored_names").([]interface{}))
+	ignoredTypes := expandStringList(d.Get("ignored_types").([]interface{}))
+	keepUnknown := d.Get("keep_unknown").(bool)
+
+	actual, err := client.GetDomainRecords(zoneUUID)
+	if err != nil {
+		return err
+	}
+
+	diff := diffZoneRecords(desired, actual, ignoredNames, ignoredTypes, keepUnknown)
+
+	// diff.keep and diff.ignored are resubmitted verbatim: the bulk PUT
+	// deletes any rrset missing from its body.
+	records := make([]types.DomainRecord, 0, len(desired)+len(diff.keep)+len(diff.ignored))
+	for _, want := range desired {
+		records = append(records, types.DomainRecord{
+			RrsetName:   want.key.name,
+			RrsetType:   want.key.recordType,
+			RrsetTTL:    want.ttl,
+			RrsetValues: want.values,
+		})
+	}
+	records = append(records, diff.keep...)
+	records = append(records, diff.ignored...)
+
+	if err = client.UpdateDomainRecords(zoneUUID, records); err != nil {
+		return err
+	}
+
+	if err = d.Set("applied_creates", len(diff.creates)); err != nil {
+		return fmt.Errorf("failed to set applied_creates: %w", err)
+	}
+	if err = d.Set("applied_changes", len(diff.changes)); err != nil {
+		return fmt.Errorf("failed to set applied_changes: %w", err)
+	}
+	if err = d.Set("applied_deletes", len(diff.deletes)); err != nil {
+		return fmt.Errorf("failed to set applied_deletes: %w", err)
+	}
+
+	d.SetId(zoneUUID)
+	return resourceLiveDNSZoneRecordsRead(d, meta)
+}
+
+func resourceLiveDNSZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zoneUUID := d.Id()
+
+	actual, err := client.GetDomainRecords(zoneUUID)
+	if err != nil {
+		return err
+	}
+	actualByKey := make(map[rrsetKey]types.DomainRecord, len(actual))
+	for _, rec := range actual {
+		actualByKey[rrsetKey{name: rec.RrsetName, recordType: rec.RrsetType}] = rec
+	}
+
+	rrsetList := d.Get("rrset").([]interface{})
+	present := make([]interface{}, 0, len(rrsetList))
+	for _, r := range rrsetList {
+		rrset := r.(map[string]interface{})
+		key := rrsetKey{name: rrset["name"].(string), recordType: rrset["type"].(string)}
+		rec, ok := actualByKey[key]
+		if !ok {
+			// No longer present upstream; drop it from state so the next
+			// plan recreates it.
+			continue
+		}
+		rrset["ttl"] = rec.RrsetTTL
+		rrset["values"] = rec.RrsetValues
+		present = append(present, rrset)
+	}
+
+	if err = d.Set("zone", zoneUUID); err != nil {
+		return fmt.Errorf("failed to set zone for %s: %w", d.Id(), err)
+	}
+	if err = d.Set("rrset", present); err != nil {
+		return fmt.Errorf("failed to set rrset for %s: %w", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceLiveDNSZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients).LiveDNS
+	zoneUUID := d.Id()
+
+	for _, want := range expandDesiredRRsets(d) {
+		if err := client.DeleteDomainRecord(zoneUUID, want.key.name, want.key.recordType); err != nil {
+			requestError, ok := err.(*types.RequestError)
+			if ok && requestError.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
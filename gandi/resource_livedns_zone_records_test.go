@@ -0,0 +1,74 @@
+package gandi
+
+import (
+	"testing"
+
+	"github.com/go-gandi/go-gandi/types"
+)
+
+func TestValuesEqual(t *testing.T) {
+	t.Run("equal regardless of order", func(t *testing.T) {
+		if !valuesEqual([]string{"a", "b"}, []string{"b", "a"}) {
+			t.Errorf("expected equal value sets to compare equal")
+		}
+	})
+
+	t.Run("different lengths are not equal", func(t *testing.T) {
+		if valuesEqual([]string{"a"}, []string{"a", "b"}) {
+			t.Errorf("expected value sets of different lengths to compare unequal")
+		}
+	})
+
+	t.Run("different values are not equal", func(t *testing.T) {
+		if valuesEqual([]string{"a", "b"}, []string{"a", "c"}) {
+			t.Errorf("expected differing value sets to compare unequal")
+		}
+	})
+}
+
+func TestDiffZoneRecords(t *testing.T) {
+	desired := []desiredRRset{
+		{key: rrsetKey{name: "www", recordType: "A"}, ttl: 3600, values: []string{"192.168.0.1"}},
+		{key: rrsetKey{name: "new", recordType: "A"}, ttl: 3600, values: []string{"192.168.0.2"}},
+	}
+
+	actual := []types.DomainRecord{
+		{RrsetName: "www", RrsetType: "A", RrsetTTL: 300, RrsetValues: []string{"192.168.0.1"}},
+		{RrsetName: "unmanaged", RrsetType: "A", RrsetTTL: 3600, RrsetValues: []string{"10.0.0.1"}},
+		{RrsetName: "@", RrsetType: "SOA", RrsetTTL: 3600, RrsetValues: []string{"ns1.gandi.net."}},
+	}
+
+	t.Run("ignored records are excluded, keep_unknown records are kept", func(t *testing.T) {
+		diff := diffZoneRecords(desired, actual, map[string]bool{}, map[string]bool{"SOA": true}, true)
+
+		if len(diff.creates) != 1 || diff.creates[0].key.name != "new" {
+			t.Errorf("expected \"new\" to be created, got %+v", diff.creates)
+		}
+		if len(diff.changes) != 1 || diff.changes[0].key.name != "www" {
+			t.Errorf("expected \"www\" to be changed (ttl drift), got %+v", diff.changes)
+		}
+		if len(diff.deletes) != 0 {
+			t.Errorf("expected nothing to be deleted, got %+v", diff.deletes)
+		}
+		if len(diff.keep) != 1 || diff.keep[0].RrsetName != "unmanaged" {
+			t.Errorf("expected \"unmanaged\" to be kept, got %+v", diff.keep)
+		}
+		if len(diff.ignored) != 1 || diff.ignored[0].RrsetName != "@" {
+			t.Errorf("expected the SOA record to be ignored, got %+v", diff.ignored)
+		}
+	})
+
+	t.Run("unmanaged records are deleted when keep_unknown is false", func(t *testing.T) {
+		diff := diffZoneRecords(desired, actual, map[string]bool{}, map[string]bool{"SOA": true}, false)
+
+		if len(diff.keep) != 0 {
+			t.Errorf("expected nothing to be kept, got %+v", diff.keep)
+		}
+		if len(diff.deletes) != 1 || diff.deletes[0].name != "unmanaged" {
+			t.Errorf("expected \"unmanaged\" to be deleted, got %+v", diff.deletes)
+		}
+		if len(diff.ignored) != 1 || diff.ignored[0].RrsetName != "@" {
+			t.Errorf("expected the SOA record to still be ignored, got %+v", diff.ignored)
+		}
+	})
+}